@@ -148,6 +148,27 @@ func RouteWithRegexp(pattern string, newComponent func() Composer) {
 	routes.routeWithRegexp(pattern, newComponent)
 }
 
+// Routed reports whether the given URL path is associated with a component,
+// either by Route or by a matching RouteWithRegexp pattern. Like navigation,
+// the root prefix of apps served under a subpath, such as with GitHubPages, is
+// removed before matching.
+//
+// Example:
+//
+//	if !app.Routed(ctx.Page().URL().Path) {
+//	    // Handle unknown path.
+//	}
+func Routed(path string) bool {
+	return routes.routed(routePath(path))
+}
+
+// RoutedPaths returns the sorted list of paths registered with Route. Patterns
+// registered with RouteWithRegexp are not included since they do not describe
+// a single path.
+func RoutedPaths() []string {
+	return routes.paths()
+}
+
 // NewZeroComponentFactory returns a function that, when invoked, creates and
 // returns a new instance of the same type as the provided component. The new
 // instance is initialized with zero values for all its fields.
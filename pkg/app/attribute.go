@@ -13,12 +13,8 @@ func (a attributes) Set(name string, value any) {
 		a[name] += toAttributeValue(value) + ";"
 
 	case "class":
-		if v := strings.TrimSpace(toAttributeValue(value)); v != "" {
-			s := a[name]
-			if s != "" {
-				s += " "
-			}
-			a[name] = s + v
+		if classes := strings.Fields(toString(value)); len(classes) != 0 {
+			a[name] = AppendClass(a[name], classes...)
 		}
 
 	case "srcset":
@@ -26,6 +26,19 @@ func TestAttributesSet(t *testing.T) {
 		require.Equal(t, "foo bar", attributes["class"])
 	})
 
+	t.Run("set classes with empty values", func(t *testing.T) {
+		div := Div().
+			Class("btn", "", "x").
+			Class("", ClassIf(false, "active"))
+		require.Equal(t, "btn x", div.(HTML).attrs()["class"])
+	})
+
+	t.Run("set only empty classes", func(t *testing.T) {
+		attributes := make(attributes)
+		attributes.Set("class", " ")
+		require.NotContains(t, attributes, "class")
+	})
+
 	t.Run("set srcset", func(t *testing.T) {
 		attributes := make(attributes)
 		attributes.Set("srcset", "/hi")
@@ -0,0 +1,109 @@
+package app
+
+import (
+	"sync"
+
+	"github.com/maxence-charriere/go-app/v10/pkg/errors"
+)
+
+// ClipboardService provides access to the system clipboard.
+//
+// On the server and in test engines, the clipboard is kept in memory so that
+// written text can be read back.
+type ClipboardService struct {
+	clipboard clipboard
+}
+
+// Supported reports whether the clipboard is available.
+func (s ClipboardService) Supported() bool {
+	return s.clipboard.supported()
+}
+
+// ReadText returns the text currently stored in the clipboard. The browser may
+// prompt the user for permission.
+func (s ClipboardService) ReadText() (string, error) {
+	if !s.Supported() {
+		return "", errors.New("clipboard is not supported by the browser")
+	}
+	return s.clipboard.readText()
+}
+
+// WriteText stores the given text into the clipboard.
+func (s ClipboardService) WriteText(v string) error {
+	if !s.Supported() {
+		return errors.New("clipboard is not supported by the browser")
+	}
+	return s.clipboard.writeText(v)
+}
+
+type clipboard interface {
+	supported() bool
+	readText() (string, error)
+	writeText(v string) error
+}
+
+type jsClipboard struct{}
+
+func (c jsClipboard) supported() bool {
+	return Window().
+		Get("navigator").
+		Get("clipboard").
+		Truthy()
+}
+
+func (c jsClipboard) readText() (string, error) {
+	res := make(chan Value, 1)
+	defer close(res)
+
+	Window().Call("goappReadClipboardText").Then(func(v Value) {
+		res <- v
+	})
+
+	v := <-res
+	if err := v.Get("error").String(); err != "" {
+		return "", errors.New("reading clipboard text failed").
+			WithTag("error", err)
+	}
+	return v.Get("text").String(), nil
+}
+
+func (c jsClipboard) writeText(v string) error {
+	res := make(chan string, 1)
+	defer close(res)
+
+	Window().Call("goappWriteClipboardText", v).Then(func(v Value) {
+		res <- v.String()
+	})
+
+	if err := <-res; err != "" {
+		return errors.New("writing clipboard text failed").
+			WithTag("error", err)
+	}
+	return nil
+}
+
+type memoryClipboard struct {
+	mu   sync.RWMutex
+	text string
+}
+
+func newMemoryClipboard() *memoryClipboard {
+	return &memoryClipboard{}
+}
+
+func (c *memoryClipboard) supported() bool {
+	return true
+}
+
+func (c *memoryClipboard) readText() (string, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.text, nil
+}
+
+func (c *memoryClipboard) writeText(v string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.text = v
+	return nil
+}
@@ -0,0 +1,43 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClipboardService(t *testing.T) {
+	testSkipWasm(t)
+
+	clipboard := newTestEngine().baseContext().Clipboard()
+	require.True(t, clipboard.Supported())
+
+	text, err := clipboard.ReadText()
+	require.NoError(t, err)
+	require.Empty(t, text)
+
+	err = clipboard.WriteText("hello")
+	require.NoError(t, err)
+
+	text, err = clipboard.ReadText()
+	require.NoError(t, err)
+	require.Equal(t, "hello", text)
+}
+
+func TestClipboardServiceNotSupported(t *testing.T) {
+	clipboard := ClipboardService{clipboard: jsClipboard{}}
+	if clipboard.Supported() {
+		t.Skip("clipboard is supported")
+	}
+
+	t.Run("read text returns an error", func(t *testing.T) {
+		text, err := clipboard.ReadText()
+		require.Error(t, err)
+		require.Empty(t, text)
+	})
+
+	t.Run("write text returns an error", func(t *testing.T) {
+		err := clipboard.WriteText("hello")
+		require.Error(t, err)
+	})
+}
@@ -2,6 +2,7 @@ package app
 
 import (
 	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/maxence-charriere/go-app/v10/pkg/errors"
@@ -88,6 +89,8 @@ type Dismounter interface {
 type Navigator interface {
 	// OnNav is invoked when the component becomes the navigation target.
 	// Use this method to handle actions or setups related to navigation events.
+	// The given context is canceled when the next navigation occurs, which
+	// makes it suitable to abort asynchronous loads that became stale.
 	// This function is always executed within the UI goroutine.
 	OnNav(Context)
 }
@@ -195,18 +198,41 @@ func (c *Compo) Render() UI {
 
 // ValueTo captures the value of the DOM element (if it exists) that triggered
 // an event, and assigns it to the provided receiver. The receiver must be a
-// pointer pointing to either a string, integer, unsigned integer, or a float.
+// pointer pointing to either a string, bool, integer, unsigned integer, float,
+// string slice, or a type implementing encoding.TextUnmarshaler. String slices
+// are filled with the comma-separated parts of the value. Bool and number
+// values that cannot be parsed set the receiver to its zero value. Other
+// failures are logged and leave the receiver unchanged.
+//
+// Bool receivers are assigned the checked state of the element, which allows
+// binding checkboxes:
+//
+//	app.Input().
+//	    Type("checkbox").
+//	    Checked(c.enabled).
+//	    OnChange(c.ValueTo(&c.enabled))
+//
 // This method panics if the provided value isn't a pointer.
 func (c *Compo) ValueTo(v any) EventHandler {
 	return func(ctx Context, e Event) {
-		value := ctx.JSSrc().Get("value")
-		if err := stringTo(value.String(), v); err != nil {
+		if err := elementValueTo(ctx.JSSrc(), v); err != nil {
 			Log(errors.New("storing dom element value failed").Wrap(err))
 			return
 		}
 	}
 }
 
+// elementValueTo assigns the value of the given DOM element to the receiver.
+// Bool receivers are assigned the checked state since the value of checkboxes
+// is "on" whether they are checked or not.
+func elementValueTo(src Value, v any) error {
+	value := src.Get("value").String()
+	if reflect.Indirect(reflect.ValueOf(v)).Kind() == reflect.Bool {
+		value = strconv.FormatBool(src.Get("checked").Bool())
+	}
+	return stringTo(value, v)
+}
+
 func (c *Compo) setRef(v Composer) Composer {
 	c.ref = v
 	return v
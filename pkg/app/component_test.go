@@ -1,5 +1,76 @@
 package app
 
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompoValueTo(t *testing.T) {
+	testSkipWasm(t)
+
+	e := newTestEngine()
+	h := &hello{}
+	e.Load(h)
+	ctx := e.nodes.context(e.baseContext(), h.root())
+
+	s := "hello"
+	h.ValueTo(&s)(ctx, Event{})
+	require.Empty(t, s)
+}
+
+func TestElementValueTo(t *testing.T) {
+	t.Run("string receiver is set from value", func(t *testing.T) {
+		var s string
+		err := elementValueTo(elementTestValue{props: map[string]any{
+			"value": "hello",
+		}}, &s)
+		require.NoError(t, err)
+		require.Equal(t, "hello", s)
+	})
+
+	t.Run("bool receiver is set from unchecked state", func(t *testing.T) {
+		b := true
+		err := elementValueTo(elementTestValue{props: map[string]any{
+			"value":   "on",
+			"checked": false,
+		}}, &b)
+		require.NoError(t, err)
+		require.False(t, b)
+	})
+
+	t.Run("bool receiver is set from checked state", func(t *testing.T) {
+		var b bool
+		err := elementValueTo(elementTestValue{props: map[string]any{
+			"value":   "on",
+			"checked": true,
+		}}, &b)
+		require.NoError(t, err)
+		require.True(t, b)
+	})
+}
+
+type elementTestValue struct {
+	value
+
+	props map[string]any
+	prop  any
+}
+
+func (v elementTestValue) Get(p string) Value {
+	return elementTestValue{prop: v.props[p]}
+}
+
+func (v elementTestValue) String() string {
+	s, _ := v.prop.(string)
+	return s
+}
+
+func (v elementTestValue) Bool() bool {
+	b, _ := v.prop.(bool)
+	return b
+}
+
 type hello struct {
 	Compo
 
@@ -22,6 +22,7 @@ type Context struct {
 	navigate              func(*url.URL, bool)
 	localStorage          BrowserStorage
 	sessionStorage        BrowserStorage
+	clipboard             clipboard
 	dispatch              func(func())
 	defere                func(func())
 	async                 func(func())
@@ -68,6 +69,27 @@ func (ctx Context) ShowAppInstallPrompt() {
 	}
 }
 
+// SetAppBadge displays the given count on the installed app icon when the
+// browser supports the Badging API. A count lower or equal to 0 clears the
+// badge.
+func (ctx Context) SetAppBadge(count int) {
+	navigator := Window().Get("navigator")
+	if !navigator.Get("setAppBadge").Truthy() {
+		return
+	}
+
+	if count <= 0 {
+		navigator.Call("clearAppBadge")
+		return
+	}
+	navigator.Call("setAppBadge", count)
+}
+
+// ClearAppBadge removes the badge displayed on the installed app icon.
+func (ctx Context) ClearAppBadge() {
+	ctx.SetAppBadge(0)
+}
+
 // DeviceID fetches a distinct identifier for the app on the present device.
 func (ctx Context) DeviceID() string {
 	var id string
@@ -176,6 +198,54 @@ func (ctx Context) Notifications() NotificationService {
 	return NotificationService{}
 }
 
+// Clipboard accesses the clipboard service.
+func (ctx Context) Clipboard() ClipboardService {
+	return ClipboardService{clipboard: ctx.clipboard}
+}
+
+// Share opens the native sharing mechanism of the device with the given data.
+// It blocks until the user completes or cancels the operation and reports
+// whether the content has been shared. An error is returned when sharing is
+// not supported by the browser or fails.
+//
+// Browsers only allow sharing in response to a user interaction, such as a
+// click. Sharing files returns an error when the browser cannot share them.
+func (ctx Context) Share(v ShareData) (bool, error) {
+	if !Window().Get("navigator").Get("share").Truthy() {
+		return false, errors.New("sharing is not supported by the browser")
+	}
+
+	data, err := v.toJS()
+	if err != nil {
+		return false, errors.New("preparing shared data failed").
+			WithTag("title", v.Title).
+			WithTag("url", v.URL).
+			Wrap(err)
+	}
+
+	res := make(chan string, 1)
+	defer close(res)
+
+	Window().Call("goappShare", data).Then(func(v Value) {
+		res <- v.String()
+	})
+
+	switch status := <-res; status {
+	case "":
+		return true, nil
+
+	case "AbortError":
+		return false, nil
+
+	default:
+		return false, errors.New("sharing failed").
+			WithTag("title", v.Title).
+			WithTag("url", v.URL).
+			WithTag("files", len(v.Files)).
+			WithTag("error", status)
+	}
+}
+
 // Dispatch prompts the execution of a function on the UI goroutine,
 // flagging the enclosing component for an update.
 func (ctx Context) Dispatch(v func(Context)) {
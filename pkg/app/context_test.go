@@ -66,6 +66,22 @@ func TestContextAppInstallable(t *testing.T) {
 	ctx.ShowAppInstallPrompt()
 }
 
+func TestContextAppBadge(t *testing.T) {
+	ctx := makeTestContext()
+	ctx.SetAppBadge(42)
+	ctx.SetAppBadge(-1)
+	ctx.ClearAppBadge()
+}
+
+func TestContextShare(t *testing.T) {
+	testSkipWasm(t)
+
+	ctx := makeTestContext()
+	shared, err := ctx.Share(ShareData{URL: "https://go-app.dev"})
+	require.Error(t, err)
+	require.False(t, shared)
+}
+
 func TestContextReload(t *testing.T) {
 	if IsClient {
 		t.Skip()
@@ -367,12 +383,15 @@ func makeTestContext() Context {
 
 	var localStorage BrowserStorage
 	var sessionStorage BrowserStorage
+	var clipboard clipboard
 	if IsServer {
 		localStorage = newMemoryStorage()
 		sessionStorage = newMemoryStorage()
+		clipboard = newMemoryClipboard()
 	} else {
 		localStorage = newJSStorage("localStorage")
 		sessionStorage = newJSStorage("sessionStorage")
+		clipboard = jsClipboard{}
 	}
 
 	return Context{
@@ -381,6 +400,7 @@ func makeTestContext() Context {
 		resolveURL:            resolveURL,
 		localStorage:          localStorage,
 		sessionStorage:        sessionStorage,
+		clipboard:             clipboard,
 		dispatch:              func(f func()) { f() },
 		defere:                func(f func()) { f() },
 		async:                 func(f func()) { f() },
@@ -18,6 +18,7 @@ type engineX struct {
 
 	localStorage   BrowserStorage
 	sessionStorage BrowserStorage
+	clipboard      clipboard
 	browser        browser
 
 	routes         *router
@@ -25,6 +26,7 @@ type engineX struct {
 	resolveURL     func(string) string
 	originPage     *requestPage
 	lastVisitedURL *url.URL
+	cancelNav      func()
 
 	nodes   nodeManager
 	updates updateManager
@@ -42,12 +44,15 @@ type engineX struct {
 func newEngine(ctx context.Context, routes *router, resolveURL func(string) string, originPage *requestPage, actionHandlers map[string]ActionHandler) *engineX {
 	var localStorage BrowserStorage
 	var sessionStorage BrowserStorage
+	var clipboard clipboard
 	if IsServer {
 		localStorage = newMemoryStorage()
 		sessionStorage = newMemoryStorage()
+		clipboard = newMemoryClipboard()
 	} else {
 		localStorage = newJSStorage("localStorage")
 		sessionStorage = newJSStorage("sessionStorage")
+		clipboard = jsClipboard{}
 	}
 
 	if resolveURL == nil {
@@ -63,6 +68,7 @@ func newEngine(ctx context.Context, routes *router, resolveURL func(string) stri
 		localStorage:               localStorage,
 		lastVisitedURL:             &url.URL{},
 		sessionStorage:             sessionStorage,
+		clipboard:                  clipboard,
 		nodes:                      nodeManager{},
 		dispatches:                 make(chan func(), 4096),
 		defers:                     make(chan func(), 4096),
@@ -82,6 +88,7 @@ func (e *engineX) baseContext() Context {
 		navigate:              e.Navigate,
 		localStorage:          e.localStorage,
 		sessionStorage:        e.sessionStorage,
+		clipboard:             e.clipboard,
 		dispatch:              e.dispatch,
 		defere:                e.defere,
 		async:                 e.async,
@@ -127,7 +134,7 @@ func (e *engineX) Navigate(destination *url.URL, updateHistory bool) {
 		}
 		e.lastVisitedURL = destination
 
-		e.nodes.NotifyComponentEvent(e.baseContext(), e.body, nav{})
+		e.nodes.NotifyComponentEvent(e.navContext(), e.body, nav{})
 
 		if destination.Fragment != "" {
 			e.defere(func() {
@@ -141,11 +148,7 @@ func (e *engineX) Navigate(destination *url.URL, updateHistory bool) {
 		return
 	}
 
-	path := strings.TrimPrefix(destination.Path, Getenv("GOAPP_ROOT_PREFIX"))
-	if !strings.HasPrefix(path, "/") {
-		path = "/" + path
-	}
-	root, ok := e.routes.createComponent(path)
+	root, ok := e.routes.createComponent(routePath(destination.Path))
 	if !ok {
 		root = &notFound{}
 	}
@@ -157,6 +160,18 @@ func (e *engineX) Navigate(destination *url.URL, updateHistory bool) {
 	}
 }
 
+// navContext returns a base context that is canceled on the next navigation,
+// canceling the one created for the previous navigation.
+func (e *engineX) navContext() Context {
+	if e.cancelNav != nil {
+		e.cancelNav()
+	}
+
+	ctx := e.baseContext()
+	ctx.Context, e.cancelNav = context.WithCancel(e.ctx)
+	return ctx
+}
+
 func (e *engineX) initBrowser() {
 	if IsServer {
 		return
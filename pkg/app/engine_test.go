@@ -19,6 +19,7 @@ func TestEngineBaseContext(t *testing.T) {
 	require.NotNil(t, ctx.navigate)
 	require.NotNil(t, ctx.localStorage)
 	require.NotNil(t, ctx.sessionStorage)
+	require.NotNil(t, ctx.clipboard)
 	require.NotNil(t, ctx.dispatch)
 	require.NotNil(t, ctx.defere)
 	require.NotNil(t, ctx.async)
@@ -150,6 +151,35 @@ func TestEngineNavigate(t *testing.T) {
 	})
 }
 
+func TestEngineNavContext(t *testing.T) {
+	e := newTestEngine()
+
+	var navCtxs []Context
+	e.routes.route("/a", func() Composer {
+		return &navigatorComponent{
+			onNav: func(ctx Context) { navCtxs = append(navCtxs, ctx) },
+		}
+	})
+	e.routes.route("/b", func() Composer {
+		return &navigatorComponent{
+			onNav: func(ctx Context) { navCtxs = append(navCtxs, ctx) },
+		}
+	})
+
+	destination, _ := url.Parse("/a")
+	e.Navigate(destination, false)
+	e.ConsumeAll()
+	require.Len(t, navCtxs, 1)
+	require.NoError(t, navCtxs[0].Err())
+
+	destination, _ = url.Parse("/b")
+	e.Navigate(destination, false)
+	e.ConsumeAll()
+	require.Len(t, navCtxs, 2)
+	require.Error(t, navCtxs[0].Err())
+	require.NoError(t, navCtxs[1].Err())
+}
+
 func TestEngineInternalURL(t *testing.T) {
 	t.Run("destination is internal URL", func(t *testing.T) {
 		os.Setenv("GOAPP_INTERNAL_URLS", `["https://murlok.io"]`)
@@ -161,6 +161,47 @@ function goappNewNotification(jsonNotification) {
   };
 }
 
+// -----------------------------------------------------------------------------
+// Share
+// -----------------------------------------------------------------------------
+async function goappShare(data) {
+  try {
+    if (data.files && navigator.canShare && !navigator.canShare(data)) {
+      return "NotSupportedError";
+    }
+    await navigator.share(data);
+    return "";
+  } catch (err) {
+    if (err.name !== "AbortError") {
+      console.error(err);
+    }
+    return err.name;
+  }
+}
+
+// -----------------------------------------------------------------------------
+// Clipboard
+// -----------------------------------------------------------------------------
+async function goappReadClipboardText() {
+  try {
+    const text = await navigator.clipboard.readText();
+    return { text: text, error: "" };
+  } catch (err) {
+    console.error(err);
+    return { text: "", error: err.toString() };
+  }
+}
+
+async function goappWriteClipboardText(text) {
+  try {
+    await navigator.clipboard.writeText(text);
+    return "";
+  } catch (err) {
+    console.error(err);
+    return err.toString();
+  }
+}
+
 // -----------------------------------------------------------------------------
 // Keep Clean Body
 // -----------------------------------------------------------------------------
@@ -121,6 +121,11 @@ type Handler struct {
 	// /sitemap.xml, and /ads.txt, which are proxied by default.
 	ProxyResources []ProxyResource
 
+	// ProtocolHandlers registers the app as a handler of custom URL schemes,
+	// such as "web+myapp://...", once installed. Invalid handlers are logged
+	// and left out of the manifest.
+	ProtocolHandlers []ProtocolHandler
+
 	// Resources resolves paths for static resources, specifically handling
 	// paths prefixed with "/web/". Defaults to app.LocalDir("").
 	Resources ResourceResolver
@@ -389,35 +394,57 @@ func (h *Handler) makeManifestJSON() []byte {
 	if err := template.
 		Must(template.New("manifest.webmanifest").Parse(manifestJSON)).
 		Execute(&b, struct {
-			ShortName       string
-			Name            string
-			Description     string
-			DefaultIcon     string
-			LargeIcon       string
-			SVGIcon         string
-			MaskableIcon    string
-			BackgroundColor string
-			ThemeColor      string
-			Scope           string
-			StartURL        string
+			ShortName        string
+			Name             string
+			Description      string
+			DefaultIcon      string
+			LargeIcon        string
+			SVGIcon          string
+			MaskableIcon     string
+			BackgroundColor  string
+			ThemeColor       string
+			Scope            string
+			StartURL         string
+			ProtocolHandlers string
 		}{
-			ShortName:       h.ShortName,
-			Name:            h.Name,
-			Description:     h.Description,
-			DefaultIcon:     h.Resources.Resolve(h.Icon.Default),
-			LargeIcon:       h.Resources.Resolve(h.Icon.Large),
-			SVGIcon:         h.Resources.Resolve(h.Icon.SVG),
-			MaskableIcon:    h.Resources.Resolve(h.Icon.Maskable),
-			BackgroundColor: h.BackgroundColor,
-			ThemeColor:      h.ThemeColor,
-			Scope:           scope,
-			StartURL:        h.Resources.Resolve("/"),
+			ShortName:        h.ShortName,
+			Name:             h.Name,
+			Description:      h.Description,
+			DefaultIcon:      h.Resources.Resolve(h.Icon.Default),
+			LargeIcon:        h.Resources.Resolve(h.Icon.Large),
+			SVGIcon:          h.Resources.Resolve(h.Icon.SVG),
+			MaskableIcon:     h.Resources.Resolve(h.Icon.Maskable),
+			BackgroundColor:  h.BackgroundColor,
+			ThemeColor:       h.ThemeColor,
+			Scope:            scope,
+			StartURL:         h.Resources.Resolve("/"),
+			ProtocolHandlers: h.makeManifestProtocolHandlers(),
 		}); err != nil {
 		panic(errors.New("initializing manifest.webmanifest failed").Wrap(err))
 	}
 	return b.Bytes()
 }
 
+func (h *Handler) makeManifestProtocolHandlers() string {
+	handlers := make([]ProtocolHandler, 0, len(h.ProtocolHandlers))
+	for _, p := range h.ProtocolHandlers {
+		if err := p.validate(); err != nil {
+			Log(errors.New("skipping invalid protocol handler").Wrap(err))
+			continue
+		}
+
+		handlers = append(handlers, ProtocolHandler{
+			Protocol: strings.ToLower(p.Protocol),
+			URL:      h.Resources.Resolve(p.URL),
+		})
+	}
+
+	if len(handlers) == 0 {
+		return ""
+	}
+	return jsonString(handlers)
+}
+
 func (h *Handler) initProxyResources() {
 	h.cachedProxyResources = newMemoryCache(len(h.ProxyResources))
 	resources := make(map[string]ProxyResource)
@@ -819,6 +846,77 @@ type Icon struct {
 	Maskable string
 }
 
+// ProtocolHandler describes a custom URL scheme handled by the installed app.
+//
+// When a link with the scheme is opened, the app is launched or focused on the
+// handler URL, where "%s" is replaced by the escaped invoking URL. The routed
+// component reads it from the page URL query, for example in OnNav.
+type ProtocolHandler struct {
+	// The URL scheme to handle, without "://". It must either be prefixed with
+	// "web+" followed by lowercase ASCII letters, such as "web+myapp", or be a
+	// scheme safelisted by browsers, such as "mailto" or "magnet".
+	Protocol string `json:"protocol"`
+
+	// The app path that handles the URL. It must start with a single "/" and
+	// contain "%s", such as "/open?url=%s".
+	URL string `json:"url"`
+}
+
+func (p ProtocolHandler) validate() error {
+	protocol := strings.ToLower(p.Protocol)
+
+	if name, ok := strings.CutPrefix(protocol, "web+"); ok {
+		if name == "" || strings.IndexFunc(name, func(r rune) bool {
+			return r < 'a' || r > 'z'
+		}) != -1 {
+			return errors.New("invalid custom protocol").
+				WithTag("protocol", p.Protocol)
+		}
+	} else if !safelistedProtocols[protocol] {
+		return errors.New("protocol is not safelisted").
+			WithTag("protocol", p.Protocol)
+	}
+
+	if !strings.Contains(p.URL, "%s") {
+		return errors.New("protocol handler url does not contain %s").
+			WithTag("protocol", p.Protocol).
+			WithTag("url", p.URL)
+	}
+	if !strings.HasPrefix(p.URL, "/") || strings.HasPrefix(p.URL, "//") {
+		return errors.New("protocol handler url is not an app path").
+			WithTag("protocol", p.Protocol).
+			WithTag("url", p.URL)
+	}
+	return nil
+}
+
+var safelistedProtocols = map[string]bool{
+	"bitcoin":     true,
+	"ftp":         true,
+	"ftps":        true,
+	"geo":         true,
+	"im":          true,
+	"irc":         true,
+	"ircs":        true,
+	"magnet":      true,
+	"mailto":      true,
+	"matrix":      true,
+	"mms":         true,
+	"news":        true,
+	"nntp":        true,
+	"openpgp4fpr": true,
+	"sftp":        true,
+	"sip":         true,
+	"sms":         true,
+	"smsto":       true,
+	"ssh":         true,
+	"tel":         true,
+	"urn":         true,
+	"webcal":      true,
+	"wtai":        true,
+	"xmpp":        true,
+}
+
 func isRemoteLocation(path string) bool {
 	return strings.HasPrefix(path, "https://") ||
 		strings.HasPrefix(path, "http://")
@@ -4,6 +4,7 @@
 package app
 
 import (
+	"encoding/json"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
@@ -415,6 +416,137 @@ func TestHandlerServeManifestJSONWithGitHubPages(t *testing.T) {
 	require.Contains(t, body, `"start_url": "/go-app"`)
 }
 
+func TestHandlerServeManifestJSONWithProtocolHandlers(t *testing.T) {
+	t.Run("protocol handlers are declared", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/manifest.webmanifest", nil)
+		w := httptest.NewRecorder()
+
+		h := Handler{
+			Resources: GitHubPages("go-app"),
+			ProtocolHandlers: []ProtocolHandler{
+				{Protocol: "web+GoApp", URL: "/open?url=%s"},
+				{Protocol: "magnet", URL: "/download?link=%s"},
+			},
+		}
+
+		h.ServeHTTP(w, r)
+
+		body := w.Body.String()
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Contains(t, body, `"protocol_handlers": [{"protocol":"web+goapp","url":"/go-app/open?url=%s"},{"protocol":"magnet","url":"/go-app/download?link=%s"}],`)
+
+		var manifest map[string]any
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &manifest))
+	})
+
+	t.Run("protocol handlers are omitted when not set", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/manifest.webmanifest", nil)
+		w := httptest.NewRecorder()
+
+		h := Handler{}
+		h.ServeHTTP(w, r)
+		require.NotContains(t, w.Body.String(), "protocol_handlers")
+	})
+
+	t.Run("invalid protocol handlers are skipped", func(t *testing.T) {
+		h := Handler{
+			ProtocolHandlers: []ProtocolHandler{
+				{Protocol: "myapp", URL: "/open?url=%s"},
+				{Protocol: "web+myapp", URL: "/open?url=%s"},
+			},
+		}
+
+		for i := 0; i < 2; i++ {
+			r := httptest.NewRequest(http.MethodGet, "/manifest.webmanifest", nil)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+
+			body := w.Body.String()
+			require.Equal(t, http.StatusOK, w.Code)
+			require.Contains(t, body, `"protocol_handlers": [{"protocol":"web+myapp","url":"/open?url=%s"}],`)
+			require.NotContains(t, body, `"protocol":"myapp"`)
+		}
+	})
+
+	t.Run("only invalid protocol handlers are omitted", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/manifest.webmanifest", nil)
+		w := httptest.NewRecorder()
+
+		h := Handler{
+			ProtocolHandlers: []ProtocolHandler{
+				{Protocol: "myapp", URL: "/open?url=%s"},
+			},
+		}
+		h.ServeHTTP(w, r)
+		require.Equal(t, http.StatusOK, w.Code)
+		require.NotContains(t, w.Body.String(), "protocol_handlers")
+	})
+}
+
+func TestProtocolHandlerValidate(t *testing.T) {
+	utests := []struct {
+		scenario string
+		handler  ProtocolHandler
+		err      bool
+	}{
+		{
+			scenario: "custom protocol is valid",
+			handler:  ProtocolHandler{Protocol: "web+myapp", URL: "/open?url=%s"},
+		},
+		{
+			scenario: "safelisted protocol is valid",
+			handler:  ProtocolHandler{Protocol: "mailto", URL: "/compose?to=%s"},
+		},
+		{
+			scenario: "custom protocol without name returns an error",
+			handler:  ProtocolHandler{Protocol: "web+", URL: "/open?url=%s"},
+			err:      true,
+		},
+		{
+			scenario: "custom protocol with non letter returns an error",
+			handler:  ProtocolHandler{Protocol: "web+my-app", URL: "/open?url=%s"},
+			err:      true,
+		},
+		{
+			scenario: "non safelisted protocol returns an error",
+			handler:  ProtocolHandler{Protocol: "myapp", URL: "/open?url=%s"},
+			err:      true,
+		},
+		{
+			scenario: "url without placeholder returns an error",
+			handler:  ProtocolHandler{Protocol: "web+myapp", URL: "/open"},
+			err:      true,
+		},
+		{
+			scenario: "remote url returns an error",
+			handler:  ProtocolHandler{Protocol: "web+myapp", URL: "https://murlok.io/open?url=%s"},
+			err:      true,
+		},
+		{
+			scenario: "protocol relative url returns an error",
+			handler:  ProtocolHandler{Protocol: "web+myapp", URL: "//evil.example/open?url=%s"},
+			err:      true,
+		},
+		{
+			scenario: "relative url returns an error",
+			handler:  ProtocolHandler{Protocol: "web+myapp", URL: "open?url=%s"},
+			err:      true,
+		},
+	}
+
+	for _, u := range utests {
+		t.Run(u.scenario, func(t *testing.T) {
+			err := u.handler.validate()
+			if u.err {
+				require.Error(t, err)
+				t.Log(err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestHandlerServeAppCSS(t *testing.T) {
 	r := httptest.NewRequest(http.MethodGet, "/app.css", nil)
 	w := httptest.NewRecorder()
@@ -536,6 +536,25 @@ func TestNodeManagerUpdate(t *testing.T) {
 		require.Equal(t, "bye", div.(HTML).attrs()["class"])
 	})
 
+	t.Run("update html with toggled off classes keeps the class attribute", func(t *testing.T) {
+		var m nodeManager
+
+		div, err := m.Mount(ctx, 1, Div().Class("btn", "x"))
+		require.NoError(t, err)
+		require.Equal(t, "btn x", div.(HTML).attrs()["class"])
+
+		newDiv := Div().Class("btn", "", "x", ClassIf(false, "active"))
+		require.Equal(t, div.(HTML).attrs()["class"], newDiv.(HTML).attrs()["class"])
+
+		div, err = m.Update(ctx, div, newDiv)
+		require.NoError(t, err)
+		require.Equal(t, "btn x", div.(HTML).attrs()["class"])
+
+		div, err = m.Update(ctx, div, Div().Class("btn", "x", ClassIf(true, "active")))
+		require.NoError(t, err)
+		require.Equal(t, "btn x active", div.(HTML).attrs()["class"])
+	})
+
 	t.Run("update html removes an attribute", func(t *testing.T) {
 		var m nodeManager
 
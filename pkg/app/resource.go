@@ -1,8 +1,11 @@
 package app
 
 import (
+	"io/fs"
 	"net/http"
 	"strings"
+
+	"github.com/maxence-charriere/go-app/v10/pkg/errors"
 )
 
 // ResourceResolver is an interface that defines the method to resolve
@@ -38,6 +41,49 @@ func (r localResourceResolver) Resolve(location string) string {
 	return r.directory + "/" + strings.Trim(location, "/")
 }
 
+// FileSystem returns a ResourceResolver for resources stored in the given file
+// system, such as an embed.FS compiled into the server binary. Paths starting
+// with /web/ are served from the "web" directory at the root of the file
+// system.
+//
+// Files missing from fsys are looked up in the fallback file systems, in the
+// given order. This allows serving embedded resources while keeping others on
+// disk.
+//
+// Example:
+//
+//	//go:embed web
+//	var web embed.FS
+//
+//	http.Handle("/", &app.Handler{
+//	    Name:      "Hello",
+//	    Resources: app.FileSystem(web, os.DirFS(".")),
+//	})
+func FileSystem(fsys fs.FS, fallbacks ...fs.FS) ResourceResolver {
+	if len(fallbacks) != 0 {
+		fsys = unionFS(append([]fs.FS{fsys}, fallbacks...))
+	}
+
+	return localResourceResolver{
+		Handler: http.FileServer(http.FS(fsys)),
+	}
+}
+
+// unionFS is a file system that opens files from the first of its file
+// systems that contains them.
+type unionFS []fs.FS
+
+func (u unionFS) Open(name string) (fs.File, error) {
+	for _, fsys := range u {
+		f, err := fsys.Open(name)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		return f, err
+	}
+	return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+}
+
 // RemoteBucket returns a ResourceResolver for remote resources. It resolves
 // paths starting with /web/ to their full URL based on the specified remote URL,
 // such as a cloud storage bucket. This resolver is ideal for resources hosted
@@ -7,8 +7,11 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"testing/fstest"
 
 	"github.com/stretchr/testify/require"
 )
@@ -43,6 +46,97 @@ func TestLocalDir(t *testing.T) {
 	}
 }
 
+func TestFileSystem(t *testing.T) {
+	testSkipWasm(t)
+
+	h, _ := FileSystem(fstest.MapFS{
+		"web/test":      {Data: []byte("hello")},
+		"web/app.wasm":  {Data: []byte("hello")},
+		"web/hello.css": {Data: []byte("hello")},
+	}).(localResourceResolver)
+	require.Equal(t, "/", h.Resolve(""))
+	require.Equal(t, "/", h.Resolve("/"))
+	require.Equal(t, "/web/app.wasm", h.Resolve("/web/app.wasm"))
+	require.Equal(t, "/web/hello.css", h.Resolve("web/hello.css"))
+	require.Equal(t, "/hello", h.Resolve("/hello"))
+	require.Equal(t, "https://go-app.dev/web/app.wasm", h.Resolve("https://go-app.dev/web/app.wasm"))
+
+	resources := []string{
+		"/web/test",
+		"/web/app.wasm",
+		"/web/hello.css",
+	}
+
+	for _, r := range resources {
+		t.Run(r, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, r, nil)
+			res := httptest.NewRecorder()
+			h.ServeHTTP(res, req)
+			require.Equal(t, http.StatusOK, res.Code)
+			require.Equal(t, "hello", res.Body.String())
+		})
+	}
+
+	t.Run("missing resource is not found", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/web/missing", nil)
+		res := httptest.NewRecorder()
+		h.ServeHTTP(res, req)
+		require.Equal(t, http.StatusNotFound, res.Code)
+	})
+}
+
+func TestFileSystemWithFallback(t *testing.T) {
+	testSkipWasm(t)
+
+	dir := "file-system-fallback-test"
+	close := testCreateDir(t, filepath.Join(dir, "web"))
+	defer close()
+	testCreateFile(t, filepath.Join(dir, "web", "disk.css"), "disk")
+	testCreateFile(t, filepath.Join(dir, "web", "hello.css"), "disk")
+
+	h, _ := FileSystem(fstest.MapFS{
+		"web/hello.css": {Data: []byte("embedded")},
+	}, os.DirFS(dir)).(localResourceResolver)
+	require.Equal(t, "/web/disk.css", h.Resolve("/web/disk.css"))
+
+	utests := []struct {
+		scenario string
+		path     string
+		code     int
+		body     string
+	}{
+		{
+			scenario: "file from the file system is served",
+			path:     "/web/hello.css",
+			code:     http.StatusOK,
+			body:     "embedded",
+		},
+		{
+			scenario: "file only on disk is served from the fallback",
+			path:     "/web/disk.css",
+			code:     http.StatusOK,
+			body:     "disk",
+		},
+		{
+			scenario: "missing file is not found",
+			path:     "/web/missing.css",
+			code:     http.StatusNotFound,
+		},
+	}
+
+	for _, u := range utests {
+		t.Run(u.scenario, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, u.path, nil)
+			res := httptest.NewRecorder()
+			h.ServeHTTP(res, req)
+			require.Equal(t, u.code, res.Code)
+			if u.body != "" {
+				require.Equal(t, u.body, res.Body.String())
+			}
+		})
+	}
+}
+
 func TestRemoteBucket(t *testing.T) {
 	utests := []struct {
 		scenario string
@@ -2,6 +2,8 @@ package app
 
 import (
 	"regexp"
+	"sort"
+	"strings"
 	"sync"
 )
 
@@ -51,6 +53,18 @@ func (r *router) routed(path string) bool {
 	return false
 }
 
+func (r *router) paths() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	paths := make([]string, 0, len(r.routes))
+	for path := range r.routes {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
 func (r *router) createComponent(path string) (Composer, bool) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
@@ -72,3 +86,13 @@ type regexpRoute struct {
 	regexp       *regexp.Regexp
 	newComponent func() Composer
 }
+
+// routePath returns the route path of the given URL path, without the root
+// prefix set when the app is served under a subpath, such as on GitHub Pages.
+func routePath(urlPath string) string {
+	path := strings.TrimPrefix(urlPath, Getenv("GOAPP_ROOT_PREFIX"))
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	return path
+}
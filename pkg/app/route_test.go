@@ -1,7 +1,9 @@
 package app
 
 import (
+	"os"
 	"reflect"
+	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -136,3 +138,59 @@ func TestRoutes(t *testing.T) {
 		})
 	}
 }
+
+func TestRouterPaths(t *testing.T) {
+	r := makeRouter()
+	require.Empty(t, r.paths())
+
+	r.route("/b", NewZeroComponentFactory(&routeCompo{}))
+	r.route("/a", NewZeroComponentFactory(&routeCompo{}))
+	r.route("/", NewZeroComponentFactory(&routeCompo{}))
+	r.routeWithRegexp("^/c.*$", NewZeroComponentFactory(&routeWithRegexpCompo{}))
+	require.Equal(t, []string{"/", "/a", "/b"}, r.paths())
+}
+
+func TestRouted(t *testing.T) {
+	Route("/test/routed", NewZeroComponentFactory(&routeCompo{}))
+	RouteWithRegexp("^/test/routed-regexp/.*$", NewZeroComponentFactory(&routeWithRegexpCompo{}))
+
+	t.Run("route paths are routed", func(t *testing.T) {
+		os.Setenv("GOAPP_ROOT_PREFIX", "/")
+		defer os.Unsetenv("GOAPP_ROOT_PREFIX")
+
+		require.True(t, Routed("/test/routed"))
+		require.True(t, Routed("/test/routed-regexp/42"))
+		require.False(t, Routed("/test/unrouted"))
+	})
+
+	t.Run("prefixed url paths are routed", func(t *testing.T) {
+		os.Setenv("GOAPP_ROOT_PREFIX", "/go-app")
+		defer os.Unsetenv("GOAPP_ROOT_PREFIX")
+
+		require.True(t, Routed("/go-app/test/routed"))
+		require.True(t, Routed("/go-app/test/routed-regexp/42"))
+		require.False(t, Routed("/go-app/test/unrouted"))
+	})
+}
+
+func TestRoutedPaths(t *testing.T) {
+	Route("/test/routed-paths", NewZeroComponentFactory(&routeCompo{}))
+	RouteWithRegexp("^/test/routed-paths-regexp/.*$", NewZeroComponentFactory(&routeWithRegexpCompo{}))
+
+	paths := RoutedPaths()
+	require.Contains(t, paths, "/test/routed-paths")
+	require.NotContains(t, paths, "^/test/routed-paths-regexp/.*$")
+	require.True(t, sort.StringsAreSorted(paths))
+}
+
+func TestRoutePath(t *testing.T) {
+	defer os.Unsetenv("GOAPP_ROOT_PREFIX")
+
+	os.Setenv("GOAPP_ROOT_PREFIX", "/")
+	require.Equal(t, "/hello", routePath("/hello"))
+	require.Equal(t, "/", routePath("/"))
+
+	os.Setenv("GOAPP_ROOT_PREFIX", "/go-app")
+	require.Equal(t, "/hello", routePath("/go-app/hello"))
+	require.Equal(t, "/", routePath("/go-app"))
+}
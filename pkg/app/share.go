@@ -0,0 +1,88 @@
+package app
+
+import (
+	"net/http"
+
+	"github.com/maxence-charriere/go-app/v10/pkg/errors"
+)
+
+// ShareData describes the content to share through the native sharing
+// mechanism of the device, such as the share sheet on mobile devices.
+type ShareData struct {
+	// The title of the shared content.
+	Title string
+
+	// The text of the shared content.
+	Text string
+
+	// The URL of the shared content.
+	URL string
+
+	// The files to share, such as images or documents.
+	Files []ShareFile
+}
+
+// ShareFile describes a file to share.
+type ShareFile struct {
+	// The file name, including its extension. Required.
+	Name string
+
+	// The MIME type of the file, such as "image/png". It is detected from
+	// Data when empty.
+	Type string
+
+	// The file content.
+	Data []byte
+}
+
+func (d ShareData) toJS() (map[string]any, error) {
+	data := make(map[string]any, 4)
+	if d.Title != "" {
+		data["title"] = d.Title
+	}
+	if d.Text != "" {
+		data["text"] = d.Text
+	}
+	if d.URL != "" {
+		data["url"] = d.URL
+	}
+
+	if len(d.Files) != 0 {
+		files := make([]any, len(d.Files))
+		for i, f := range d.Files {
+			file, err := f.toJS()
+			if err != nil {
+				return nil, errors.New("converting share file failed").
+					WithTag("index", i).
+					Wrap(err)
+			}
+			files[i] = file
+		}
+		data["files"] = files
+	}
+	return data, nil
+}
+
+func (f ShareFile) mimeType() string {
+	if f.Type != "" {
+		return f.Type
+	}
+	return http.DetectContentType(f.Data)
+}
+
+func (f ShareFile) toJS() (Value, error) {
+	if f.Name == "" {
+		return nil, errors.New("share file has no name").
+			WithTag("type", f.Type).
+			WithTag("size", len(f.Data))
+	}
+
+	content := Window().Get("Uint8Array").New(len(f.Data))
+	CopyBytesToJS(content, f.Data)
+
+	return Window().Get("File").New(
+		[]any{content},
+		f.Name,
+		map[string]any{"type": f.mimeType()},
+	), nil
+}
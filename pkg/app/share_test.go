@@ -0,0 +1,59 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestShareDataToJS(t *testing.T) {
+	t.Run("empty fields are omitted", func(t *testing.T) {
+		data, err := ShareData{}.toJS()
+		require.NoError(t, err)
+		require.Empty(t, data)
+	})
+
+	t.Run("fields are converted", func(t *testing.T) {
+		data, err := ShareData{
+			Title: "go-app",
+			Text:  "Build PWAs with Go",
+			URL:   "https://go-app.dev",
+		}.toJS()
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{
+			"title": "go-app",
+			"text":  "Build PWAs with Go",
+			"url":   "https://go-app.dev",
+		}, data)
+	})
+
+	t.Run("files are converted", func(t *testing.T) {
+		data, err := ShareData{
+			Files: []ShareFile{
+				{Name: "logo.png", Data: []byte("\x89PNG\r\n\x1a\n")},
+				{Name: "notes.txt", Type: "text/plain", Data: []byte("hello")},
+			},
+		}.toJS()
+		require.NoError(t, err)
+		require.Len(t, data["files"], 2)
+	})
+
+	t.Run("file without name returns an error", func(t *testing.T) {
+		_, err := ShareData{
+			Files: []ShareFile{{Type: "text/plain", Data: []byte("hello")}},
+		}.toJS()
+		require.Error(t, err)
+	})
+}
+
+func TestShareFileMimeType(t *testing.T) {
+	t.Run("type is detected from data", func(t *testing.T) {
+		f := ShareFile{Name: "logo.png", Data: []byte("\x89PNG\r\n\x1a\n")}
+		require.Equal(t, "image/png", f.mimeType())
+	})
+
+	t.Run("type is used when set", func(t *testing.T) {
+		f := ShareFile{Name: "data.csv", Type: "text/csv", Data: []byte("a,b")}
+		require.Equal(t, "text/csv", f.mimeType())
+	})
+}
@@ -35,7 +35,7 @@ func GenerateStaticWebsite(dir string, h *Handler, pages ...string) error {
 		"/web":                  {},
 	}
 
-	for path := range routes.routes {
+	for _, path := range routes.paths() {
 		resources[path] = struct{}{}
 	}
 
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"encoding"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -65,10 +66,18 @@ func stringTo(s string, v any) error {
 	}
 	val = val.Elem()
 
+	if unmarshaler, ok := v.(encoding.TextUnmarshaler); ok {
+		return unmarshaler.UnmarshalText([]byte(s))
+	}
+
 	switch val.Kind() {
 	case reflect.String:
 		val.SetString(s)
 
+	case reflect.Bool:
+		b, _ := strconv.ParseBool(s)
+		val.SetBool(b)
+
 	case reflect.Int,
 		reflect.Int8,
 		reflect.Int16,
@@ -93,6 +102,21 @@ func stringTo(s string, v any) error {
 		f, _ := strconv.ParseFloat(s, 32)
 		val.SetFloat(f)
 
+	case reflect.Slice:
+		if val.Type().Elem().Kind() != reflect.String {
+			return errors.New("string cannot be converted to receiver type").
+				WithTag("string", s).
+				WithTag("receiver-type", val.Type())
+		}
+
+		items := reflect.MakeSlice(val.Type(), 0, strings.Count(s, ",")+1)
+		for _, item := range strings.Split(s, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = reflect.Append(items, reflect.ValueOf(item).Convert(val.Type().Elem()))
+			}
+		}
+		val.Set(items)
+
 	default:
 		return errors.New("string cannot be converted to receiver type").
 			WithTag("string", s).
@@ -122,6 +146,18 @@ func AppendClass(class string, v ...string) string {
 	return b.String()
 }
 
+// ClassIf returns the given class when cond is true, and an empty string
+// otherwise. Empty classes are ignored by Class, which allows toggling classes
+// from boolean fields:
+//
+//	app.Button().Class("btn", app.ClassIf(c.active, "active"))
+func ClassIf(cond bool, class string) string {
+	if !cond {
+		return ""
+	}
+	return class
+}
+
 func jsonString(v any) string {
 	b, err := json.Marshal(v)
 	if err != nil {
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -97,6 +98,71 @@ func TestStringToFloat(t *testing.T) {
 	require.Equal(t, float64(0), f64)
 }
 
+func TestStringToBool(t *testing.T) {
+	var b bool
+
+	err := stringTo("true", &b)
+	require.NoError(t, err)
+	require.True(t, b)
+
+	err = stringTo("false", &b)
+	require.NoError(t, err)
+	require.False(t, b)
+
+	b = true
+	err = stringTo("hello", &b)
+	require.NoError(t, err)
+	require.False(t, b)
+}
+
+func TestStringToStringSlice(t *testing.T) {
+	type tags []string
+
+	var s []string
+	err := stringTo("foo, bar,,baz ", &s)
+	require.NoError(t, err)
+	require.Equal(t, []string{"foo", "bar", "baz"}, s)
+
+	err = stringTo("", &s)
+	require.NoError(t, err)
+	require.Empty(t, s)
+
+	var ts tags
+	err = stringTo("foo,bar", &ts)
+	require.NoError(t, err)
+	require.Equal(t, tags{"foo", "bar"}, ts)
+
+	var is []int
+	err = stringTo("1,2", &is)
+	require.Error(t, err)
+	t.Log(err)
+}
+
+type upperText string
+
+func (t *upperText) UnmarshalText(b []byte) error {
+	*t = upperText(strings.ToUpper(string(b)))
+	return nil
+}
+
+func TestStringToTextUnmarshaler(t *testing.T) {
+	var text upperText
+	err := stringTo("hello", &text)
+	require.NoError(t, err)
+	require.Equal(t, upperText("HELLO"), text)
+}
+
+func TestStringToUnsupportedReceiver(t *testing.T) {
+	var m map[string]string
+	err := stringTo("hello", &m)
+	require.Error(t, err)
+	t.Log(err)
+
+	err = stringTo("hello", "hello")
+	require.Error(t, err)
+	t.Log(err)
+}
+
 func TestAppendClass(t *testing.T) {
 	utests := []struct {
 		scenario       string
@@ -163,3 +229,8 @@ func TestPreviewText(t *testing.T) {
 		)
 	})
 }
+
+func TestClassIf(t *testing.T) {
+	require.Equal(t, "active", ClassIf(true, "active"))
+	require.Empty(t, ClassIf(false, "active"))
+}